@@ -0,0 +1,238 @@
+// Package acme implements a DNS-01 challenge provider for go-acme/lego
+// backed by the DNSPod API client in this module.
+package acme
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+
+	"github.com/leganck/dnspod-go"
+)
+
+const (
+	defaultTTL               = 600
+	defaultPropagationTimeout = 2 * time.Minute
+	defaultPollingInterval    = 5 * time.Second
+)
+
+// Config configures a DNSProvider.
+type Config struct {
+	APIToken           string
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a Config populated with the provider's defaults.
+// APIToken is left empty; callers must set it before use.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                defaultTTL,
+		PropagationTimeout: defaultPropagationTimeout,
+		PollingInterval:    defaultPollingInterval,
+		HTTPClient:         http.DefaultClient,
+	}
+}
+
+// DNSProvider implements challenge.Provider for the DNSPod API.
+type DNSProvider struct {
+	config *Config
+	client *dnspod.Client
+
+	mu      sync.Mutex
+	entries map[string][]dnsEntry
+}
+
+// dnsEntry is a single challenge TXT record Present created. value is kept
+// alongside the IDs so CleanUp, given only a domain/keyAuth pair, can tell
+// which of possibly several records sharing the same fqdn to remove: lego
+// calls Present once per SAN, and a domain requested together with its
+// wildcard (e.g. example.com and *.example.com) share the same
+// _acme-challenge fqdn but get distinct keyAuth values.
+type dnsEntry struct {
+	domainID string
+	recordID string
+	value    string
+}
+
+var _ challenge.Provider = (*DNSProvider)(nil)
+
+// NewDNSProvider returns a DNSProvider configured from the DNSPOD_API_TOKEN
+// environment variable.
+func NewDNSProvider() (*DNSProvider, error) {
+	apiToken := os.Getenv("DNSPOD_API_TOKEN")
+	if apiToken == "" {
+		return nil, errors.New("dnspod: DNSPOD_API_TOKEN environment variable is not set")
+	}
+
+	config := NewDefaultConfig()
+	config.APIToken = apiToken
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig returns a DNSProvider configured with config.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("dnspod: the configuration of the DNS provider is nil")
+	}
+
+	if config.APIToken == "" {
+		return nil, errors.New("dnspod: credentials missing")
+	}
+
+	client := dnspod.NewClient(dnspod.NewCommonParams(config.APIToken, "json"))
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config:  config,
+		client:  client,
+		entries: make(map[string][]dnsEntry),
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS
+// propagation, satisfying lego's challenge.ProviderTimeout interface.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record to fulfil the DNS-01 challenge.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	fqdn, value := toChallengeRecord(domain, keyAuth)
+
+	zone, domainID, err := d.findZone(domain)
+	if err != nil {
+		return fmt.Errorf("dnspod: %w", err)
+	}
+
+	subDomain := toSubDomain(fqdn, zone)
+
+	record, _, err := d.client.Records.Create(zone, domainID, dnspod.Record{
+		Name:  subDomain,
+		Type:  dnspod.RecordTypeTXT,
+		Value: value,
+		Line:  "默认",
+		TTL:   d.config.TTL,
+	})
+	if err != nil {
+		return fmt.Errorf("dnspod: failed to create TXT record: %w", err)
+	}
+
+	d.mu.Lock()
+	d.entries[fqdn] = append(d.entries[fqdn], dnsEntry{domainID: domainID, recordID: record.ID, value: value})
+	d.mu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the TXT record created by the matching Present call.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn, value := toChallengeRecord(domain, keyAuth)
+
+	entry, ok := d.takeEntry(fqdn, value)
+	if !ok {
+		return nil
+	}
+
+	domainIDInt, err := strconv.Atoi(entry.domainID)
+	if err != nil {
+		return fmt.Errorf("dnspod: invalid domain id %q: %w", entry.domainID, err)
+	}
+
+	zone, _, err := d.findZone(domain)
+	if err != nil {
+		return fmt.Errorf("dnspod: %w", err)
+	}
+
+	if _, err := d.client.Records.Delete(domainIDInt, zone, entry.recordID); err != nil {
+		return fmt.Errorf("dnspod: failed to delete TXT record: %w", err)
+	}
+
+	return nil
+}
+
+// takeEntry removes and returns the entry under fqdn whose value matches,
+// leaving any other entries sharing the same fqdn (e.g. a sibling wildcard
+// challenge) untouched.
+func (d *DNSProvider) takeEntry(fqdn, value string) (dnsEntry, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entries := d.entries[fqdn]
+	for i, entry := range entries {
+		if entry.value != value {
+			continue
+		}
+
+		entries = append(entries[:i], entries[i+1:]...)
+		if len(entries) == 0 {
+			delete(d.entries, fqdn)
+		} else {
+			d.entries[fqdn] = entries
+		}
+
+		return entry, true
+	}
+
+	return dnsEntry{}, false
+}
+
+// findZone resolves the registered zone for domain by matching the longest
+// suffix among the zones returned by DomainsService.List.
+func (d *DNSProvider) findZone(domain string) (zone string, domainID string, err error) {
+	domains, _, err := d.client.Domains.List()
+	if err != nil {
+		return "", "", fmt.Errorf("could not list domains: %w", err)
+	}
+
+	name := strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	var best dnspod.Domain
+	for _, candidate := range domains {
+		candidateName := strings.ToLower(candidate.Name)
+		if name != candidateName && !strings.HasSuffix(name, "."+candidateName) {
+			continue
+		}
+		if len(candidateName) > len(best.Name) {
+			best = candidate
+		}
+	}
+
+	if best.Name == "" {
+		return "", "", fmt.Errorf("no matching zone found for domain %q", domain)
+	}
+
+	return best.Name, best.ID.String(), nil
+}
+
+func toChallengeRecord(domain, keyAuth string) (fqdn, value string) {
+	sum := sha256.Sum256([]byte(keyAuth))
+	value = base64.RawURLEncoding.EncodeToString(sum[:])
+	fqdn = "_acme-challenge." + strings.TrimSuffix(domain, ".") + "."
+
+	return fqdn, value
+}
+
+func toSubDomain(fqdn, zone string) string {
+	name := strings.TrimSuffix(fqdn, ".")
+	zone = strings.TrimSuffix(zone, ".")
+
+	sub := strings.TrimSuffix(name, zone)
+
+	return strings.TrimSuffix(sub, ".")
+}
+
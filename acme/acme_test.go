@@ -0,0 +1,73 @@
+package acme
+
+import "testing"
+
+func TestToChallengeRecord(t *testing.T) {
+	fqdn, value := toChallengeRecord("example.com", "key-auth-value")
+
+	if want := "_acme-challenge.example.com."; fqdn != want {
+		t.Errorf("fqdn = %q, want %q", fqdn, want)
+	}
+
+	if value == "" {
+		t.Error("value is empty")
+	}
+}
+
+func TestDNSProviderTakeEntrySameFQDNDistinctValues(t *testing.T) {
+	d := &DNSProvider{entries: make(map[string][]dnsEntry)}
+
+	fqdn := "_acme-challenge.example.com."
+	d.entries[fqdn] = []dnsEntry{
+		{domainID: "1", recordID: "101", value: "apex-value"},
+		{domainID: "1", recordID: "102", value: "wildcard-value"},
+	}
+
+	entry, ok := d.takeEntry(fqdn, "apex-value")
+	if !ok {
+		t.Fatal("takeEntry() ok = false, want true")
+	}
+	if entry.recordID != "101" {
+		t.Errorf("recordID = %q, want %q", entry.recordID, "101")
+	}
+
+	remaining, ok := d.entries[fqdn]
+	if !ok || len(remaining) != 1 || remaining[0].recordID != "102" {
+		t.Errorf("remaining entries = %v, want the wildcard entry untouched", remaining)
+	}
+
+	if _, ok := d.takeEntry(fqdn, "apex-value"); ok {
+		t.Error("takeEntry() for an already-removed value ok = true, want false")
+	}
+
+	entry, ok = d.takeEntry(fqdn, "wildcard-value")
+	if !ok || entry.recordID != "102" {
+		t.Errorf("takeEntry() = %v, %v, want the wildcard entry", entry, ok)
+	}
+
+	if _, ok := d.entries[fqdn]; ok {
+		t.Error("entries[fqdn] still present after removing the last entry")
+	}
+}
+
+func TestToSubDomain(t *testing.T) {
+	tests := []struct {
+		name string
+		fqdn string
+		zone string
+		want string
+	}{
+		{"simple subdomain", "_acme-challenge.www.example.com.", "example.com", "_acme-challenge.www"},
+		{"apex challenge", "_acme-challenge.example.com.", "example.com", "_acme-challenge"},
+		{"zone with trailing dot", "_acme-challenge.www.example.com.", "example.com.", "_acme-challenge.www"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toSubDomain(tt.fqdn, tt.zone)
+			if got != tt.want {
+				t.Errorf("toSubDomain(%q, %q) = %q, want %q", tt.fqdn, tt.zone, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package dnspod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	methodBatchRecordCreate = "Batch.Record.Create"
+	methodBatchRecordModify = "Batch.Record.Modify"
+	methodBatchDetail       = "Batch.Detail"
+)
+
+// BatchChange describes a single record modification submitted to
+// BatchService.ModifyRecords.
+type BatchChange struct {
+	RecordID   string `json:"record_id,omitempty"`
+	SubDomain  string `json:"sub_domain,omitempty"`
+	RecordType string `json:"record_type,omitempty"`
+	RecordLine string `json:"record_line,omitempty"`
+	Value      string `json:"value,omitempty"`
+	TTL        string `json:"ttl,omitempty"`
+	MX         string `json:"mx,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+// batchCreateRecord is the wire shape Batch.Record.Create expects for each
+// row: the same snake_case field names applyRecordAttributes sends for a
+// single-record Record.Create, not RawRecord's response-shaped tags.
+type batchCreateRecord struct {
+	SubDomain    string `json:"sub_domain,omitempty"`
+	RecordType   string `json:"record_type,omitempty"`
+	RecordLine   string `json:"record_line,omitempty"`
+	RecordLineID string `json:"record_line_id,omitempty"`
+	Value        string `json:"value,omitempty"`
+	MX           string `json:"mx,omitempty"`
+	TTL          string `json:"ttl,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+}
+
+func newBatchCreateRecord(raw RawRecord) batchCreateRecord {
+	return batchCreateRecord{
+		SubDomain:    raw.Name,
+		RecordType:   raw.Type,
+		RecordLine:   raw.Line,
+		RecordLineID: raw.LineID,
+		Value:        raw.Value,
+		MX:           raw.MX,
+		TTL:          raw.TTL,
+		Status:       raw.Status,
+		Weight:       raw.Weight,
+	}
+}
+
+// BatchRowResult is the outcome of a single row of a batch job, as reported
+// by BatchService.Detail.
+type BatchRowResult struct {
+	Index   int    `json:"index"`
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
+
+// BatchJob is the state of a batch job as reported by BatchService.Detail.
+type BatchJob struct {
+	JobID   string           `json:"job_id"`
+	Status  string           `json:"status"`
+	Total   int              `json:"total"`
+	Success int              `json:"success"`
+	Failed  int              `json:"failed"`
+	Rows    []BatchRowResult `json:"rows"`
+}
+
+// Done reports whether the job has finished processing, successfully or
+// not.
+func (j BatchJob) Done() bool {
+	return j.Status == "done" || j.Status == "failed"
+}
+
+// BatchError reports the rows of a batch job that failed to process.
+type BatchError struct {
+	JobID      string
+	FailedRows []int
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("dnspod: batch job %s failed on rows %v", e.JobID, e.FailedRows)
+}
+
+type batchJobWrapper struct {
+	Status Status   `json:"status"`
+	JobID  string   `json:"job_id"`
+	Job    BatchJob `json:"job"`
+}
+
+func (w batchJobWrapper) dnspodStatus() Status { return w.Status }
+
+// BatchService handles communication with the batch record related methods
+// of the DNSPod API.
+//
+// DNSPod API docs:
+// - https://www.dnspod.cn/docs/records.html
+type BatchService struct {
+	client *Client
+}
+
+// CreateRecords submits records for creation under domainID in a single
+// batch job and returns the job ID, to be polled with Detail or
+// WaitForCompletion.
+func (s *BatchService) CreateRecords(domainID string, records []Record) (jobID string, err error) {
+	return s.CreateRecordsWithContext(context.Background(), domainID, records)
+}
+
+// CreateRecordsWithContext is CreateRecords, with ctx threaded through to the
+// underlying request so callers can bound or cancel it.
+func (s *BatchService) CreateRecordsWithContext(ctx context.Context, domainID string, records []Record) (jobID string, err error) {
+	rows := make([]batchCreateRecord, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, newBatchCreateRecord(record.toRaw()))
+	}
+
+	encoded, err := json.Marshal(rows)
+	if err != nil {
+		return "", fmt.Errorf("dnspod: could not encode records: %w", err)
+	}
+
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain_id", domainID)
+	payload.Add("records", string(encoded))
+
+	wrapped := batchJobWrapper{}
+
+	_, err = s.client.doWithMiddleware(ctx, methodBatchRecordCreate, payload, &wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	if wrapped.Status.Code != "1" {
+		return "", fmt.Errorf("could not create batch job: %s", wrapped.Status.Message)
+	}
+
+	return wrapped.JobID, nil
+}
+
+// ModifyRecords submits changes for domainID in a single batch job and
+// returns the job ID, to be polled with Detail or WaitForCompletion.
+func (s *BatchService) ModifyRecords(domainID string, changes []BatchChange) (jobID string, err error) {
+	return s.ModifyRecordsWithContext(context.Background(), domainID, changes)
+}
+
+// ModifyRecordsWithContext is ModifyRecords, with ctx threaded through to the
+// underlying request so callers can bound or cancel it.
+func (s *BatchService) ModifyRecordsWithContext(ctx context.Context, domainID string, changes []BatchChange) (jobID string, err error) {
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return "", fmt.Errorf("dnspod: could not encode changes: %w", err)
+	}
+
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain_id", domainID)
+	payload.Add("changes", string(encoded))
+
+	wrapped := batchJobWrapper{}
+
+	_, err = s.client.doWithMiddleware(ctx, methodBatchRecordModify, payload, &wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	if wrapped.Status.Code != "1" {
+		return "", fmt.Errorf("could not create batch job: %s", wrapped.Status.Message)
+	}
+
+	return wrapped.JobID, nil
+}
+
+// Detail fetches the current state of a batch job.
+func (s *BatchService) Detail(jobID string) (BatchJob, error) {
+	return s.DetailWithContext(context.Background(), jobID)
+}
+
+// DetailWithContext is Detail, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *BatchService) DetailWithContext(ctx context.Context, jobID string) (BatchJob, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("job_id", jobID)
+
+	wrapped := batchJobWrapper{}
+
+	_, err := s.client.doWithMiddleware(ctx, methodBatchDetail, payload, &wrapped)
+	if err != nil {
+		return BatchJob{}, err
+	}
+
+	if wrapped.Status.Code != "1" {
+		return BatchJob{}, fmt.Errorf("could not get batch job detail: %s", wrapped.Status.Message)
+	}
+
+	return wrapped.Job, nil
+}
+
+// WaitForCompletion polls Detail every pollInterval until jobID finishes or
+// ctx is done. If the job finishes with any failed rows, it returns the
+// final BatchJob alongside a *BatchError naming the failing row indices.
+func (s *BatchService) WaitForCompletion(ctx context.Context, jobID string, pollInterval time.Duration) (BatchJob, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := s.DetailWithContext(ctx, jobID)
+		if err != nil {
+			return BatchJob{}, err
+		}
+
+		if job.Done() {
+			if job.Failed == 0 {
+				return job, nil
+			}
+
+			failedRows := make([]int, 0, job.Failed)
+			for _, row := range job.Rows {
+				if !row.Success {
+					failedRows = append(failedRows, row.Index)
+				}
+			}
+
+			return job, &BatchError{JobID: jobID, FailedRows: failedRows}
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
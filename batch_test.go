@@ -0,0 +1,69 @@
+package dnspod
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBatchChangeMarshalsSnakeCase(t *testing.T) {
+	change := BatchChange{
+		RecordID:   "123",
+		SubDomain:  "www",
+		RecordType: "A",
+		RecordLine: "默认",
+		Value:      "203.0.113.1",
+		TTL:        "600",
+		MX:         "",
+		Status:     "enable",
+	}
+
+	encoded, err := json.Marshal(change)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	for _, key := range []string{"record_id", "sub_domain", "record_type", "record_line", "value", "ttl", "status"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("encoded change missing key %q, got %v", key, got)
+		}
+	}
+
+	for _, key := range []string{"RecordID", "SubDomain", "RecordType"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("encoded change has Go field name %q, want snake_case", key)
+		}
+	}
+}
+
+func TestNewBatchCreateRecordMarshalsSnakeCase(t *testing.T) {
+	record := Record{Name: "www", Type: RecordTypeA, Value: "203.0.113.1", TTL: 600}
+
+	row := newBatchCreateRecord(record.toRaw())
+
+	encoded, err := json.Marshal(row)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	for _, key := range []string{"sub_domain", "record_type", "value", "ttl"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("encoded row missing key %q, got %v", key, got)
+		}
+	}
+
+	for _, key := range []string{"name", "type"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("encoded row has RawRecord response tag %q, want create-request shape", key)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package dnspod
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// postWithContext runs the middleware chain, passing ctx all the way down
+// to postContext so the underlying HTTP transport can honor cancellation
+// and deadlines directly (via http.NewRequestWithContext) instead of a
+// request that keeps running, unobserved, after the caller gives up on it.
+func (c *Client) postWithContext(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error) {
+	return c.doWithMiddleware(ctx, method, payload, v)
+}
+
+// ListWithContext is List, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *DomainsService) ListWithContext(ctx context.Context) ([]Domain, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+
+	returnedDomains := domainListWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodDomainList, payload, &returnedDomains)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if returnedDomains.Status.Code != "1" {
+		return nil, nil, fmt.Errorf("could not get domains: %s", returnedDomains.Status.Message)
+	}
+
+	return returnedDomains.Domains, res, nil
+}
+
+// CreateWithContext is Create, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *DomainsService) CreateWithContext(ctx context.Context, domainAttributes Domain) (Domain, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Set("domain", domainAttributes.Name)
+	payload.Set("group_id", domainAttributes.GroupID.String())
+	payload.Set("is_mark", domainAttributes.IsMark)
+
+	returnedDomain := domainWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodDomainCreate, payload, &returnedDomain)
+	if err != nil {
+		return Domain{}, res, err
+	}
+
+	return returnedDomain.Domain, res, nil
+}
+
+// GetWithContext is Get, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *DomainsService) GetWithContext(ctx context.Context, id, domain string) (Domain, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Set("domain_id", id)
+	payload.Set("domain", domain)
+
+	returnedDomain := domainWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodDomainInfo, payload, &returnedDomain)
+	if err != nil {
+		return Domain{}, res, err
+	}
+
+	return returnedDomain.Domain, res, nil
+}
+
+// DeleteWithContext is Delete, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *DomainsService) DeleteWithContext(ctx context.Context, id string, domain string) (*Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Set("domain_id", id)
+	payload.Set("domain", domain)
+	returnedDomain := domainWrapper{}
+
+	return s.client.postWithContext(ctx, methodDomainRemove, payload, &returnedDomain)
+}
+
+// ListWithContext is List, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *RecordsService) ListWithContext(ctx context.Context, p ListParams) ([]Record, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload = p.ToURLValues(payload)
+	if p.DomainID != "" {
+		payload.Add("domain_id", p.DomainID)
+	}
+	if p.Domain != "" {
+		payload.Add("domain", p.Domain)
+	}
+	if p.RecordType != "" {
+		payload.Add("record_type", p.RecordType)
+	}
+
+	wrappedRecords := recordsWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodRecordList, payload, &wrappedRecords)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if wrappedRecords.Status.Code != "1" && wrappedRecords.Status.Code != "10" {
+		return nil, nil, fmt.Errorf("could not get domains: %s", wrappedRecords.Status.Message)
+	}
+
+	records := make([]Record, 0, len(wrappedRecords.Records))
+	for _, raw := range wrappedRecords.Records {
+		record, err := raw.ToRecord()
+		if err != nil {
+			return nil, res, fmt.Errorf("dnspod: could not parse record %s: %w", raw.ID, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, res, nil
+}
+
+// CreateWithContext is Create, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *RecordsService) CreateWithContext(ctx context.Context, domain, domainId string, attrs recordAttributes) (RawRecord, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain", domain)
+	payload.Add("domain_id", domainId)
+	applyRecordAttributes(payload, attrs)
+
+	returnedRecord := recordWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodRecordCreate, payload, &returnedRecord)
+	if err != nil {
+		return RawRecord{}, res, err
+	}
+
+	if returnedRecord.Status.Code != "1" {
+		return returnedRecord.Record, nil, fmt.Errorf("could not create domains: %s", returnedRecord.Status.Message)
+	}
+
+	return returnedRecord.Record, res, nil
+}
+
+// GetWithContext is Get, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *RecordsService) GetWithContext(ctx context.Context, domain, domainId string, recordID int) (Record, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain", domain)
+	payload.Add("domain_id", domainId)
+	payload.Add("record_id", fmt.Sprintf("%d", recordID))
+
+	returnedRecord := recordWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodRecordInfo, payload, &returnedRecord)
+	if err != nil {
+		return Record{}, res, err
+	}
+
+	if returnedRecord.Status.Code != "1" {
+		return Record{}, nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
+	}
+
+	record, err := returnedRecord.Record.ToRecord()
+	if err != nil {
+		return Record{}, res, fmt.Errorf("dnspod: could not parse record %s: %w", returnedRecord.Record.ID, err)
+	}
+
+	return record, res, nil
+}
+
+// UpdateWithContext is Update, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *RecordsService) UpdateWithContext(ctx context.Context, domainId, domain, recordID string, attrs recordAttributes) (RecordModify, *Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain_id", domainId)
+	payload.Add("domain", domain)
+	payload.Add("record_id", recordID)
+	applyRecordAttributes(payload, attrs)
+
+	returnedRecord := recordModifyWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodRecordModify, payload, &returnedRecord)
+	if err != nil {
+		return RecordModify{}, res, err
+	}
+
+	if returnedRecord.Status.Code != "1" {
+		return returnedRecord.Record, nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
+	}
+
+	return returnedRecord.Record, res, nil
+}
+
+// DeleteWithContext is Delete, with ctx threaded through to the underlying
+// request so callers can bound or cancel it.
+func (s *RecordsService) DeleteWithContext(ctx context.Context, domainId int, domain, recordID string) (*Response, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload.Add("domain_id", fmt.Sprintf("%d", domainId))
+	payload.Add("domain", domain)
+	payload.Add("record_id", recordID)
+
+	returnedRecord := recordWrapper{}
+
+	res, err := s.client.postWithContext(ctx, methodRecordRemove, payload, &returnedRecord)
+	if err != nil {
+		return res, err
+	}
+
+	if returnedRecord.Status.Code != "1" {
+		return nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
+	}
+
+	return res, nil
+}
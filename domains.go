@@ -1,8 +1,8 @@
 package dnspod
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 )
 
 const (
@@ -12,7 +12,9 @@ const (
 	methodDomainRemove = "Domain.Remove"
 )
 
-// DomainInfo handles domain information.
+// DomainInfo handles domain information. Record.List reuses it to report
+// RecordTotal, the total number of records matching a query across all
+// pages; the domain.* totals are left zero in that response.
 type DomainInfo struct {
 	DomainTotal   json.Number `json:"domain_total,omitempty"`
 	AllTotal      json.Number `json:"all_total,omitempty"`
@@ -26,6 +28,7 @@ type DomainInfo struct {
 	SpamTotal     json.Number `json:"spam_total,omitempty"`
 	VipExpire     json.Number `json:"vip_expire,omitempty"`
 	ShareOutTotal json.Number `json:"share_out_total,omitempty"`
+	RecordTotal   json.Number `json:"record_total,omitempty"`
 }
 
 // Domain handles domain.
@@ -58,12 +61,16 @@ type domainListWrapper struct {
 	Domains []Domain   `json:"domains"`
 }
 
+func (w domainListWrapper) dnspodStatus() Status { return w.Status }
+
 type domainWrapper struct {
 	Status Status     `json:"status"`
 	Info   DomainInfo `json:"info"`
 	Domain Domain     `json:"domain"`
 }
 
+func (w domainWrapper) dnspodStatus() Status { return w.Status }
+
 // DomainsService handles communication with the domain related methods of the DNSPod API.
 //
 // DNSPod API docs:
@@ -79,20 +86,7 @@ type DomainsService struct {
 // - https://www.dnspod.cn/docs/domains.html#domain-list
 // - https://docs.dnspod.com/api/5fe1b40a6e336701a2111f5b/
 func (s *DomainsService) List() ([]Domain, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-
-	returnedDomains := domainListWrapper{}
-
-	res, err := s.client.post(methodDomainList, payload, &returnedDomains)
-	if err != nil {
-		return nil, res, err
-	}
-
-	if returnedDomains.Status.Code != "1" {
-		return nil, nil, fmt.Errorf("could not get domains: %s", returnedDomains.Status.Message)
-	}
-
-	return returnedDomains.Domains, res, nil
+	return s.ListWithContext(context.Background())
 }
 
 // Create a new domain.
@@ -101,19 +95,7 @@ func (s *DomainsService) List() ([]Domain, *Response, error) {
 // - https://www.dnspod.cn/docs/domains.html#domain-create
 // - https://docs.dnspod.com/api/5fe1a9e36e336701a2111d3d/
 func (s *DomainsService) Create(domainAttributes Domain) (Domain, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Set("domain", domainAttributes.Name)
-	payload.Set("group_id", domainAttributes.GroupID.String())
-	payload.Set("is_mark", domainAttributes.IsMark)
-
-	returnedDomain := domainWrapper{}
-
-	res, err := s.client.post(methodDomainCreate, payload, &returnedDomain)
-	if err != nil {
-		return Domain{}, res, err
-	}
-
-	return returnedDomain.Domain, res, nil
+	return s.CreateWithContext(context.Background(), domainAttributes)
 }
 
 // Get fetches a domain.
@@ -122,18 +104,7 @@ func (s *DomainsService) Create(domainAttributes Domain) (Domain, *Response, err
 // - https://www.dnspod.cn/docs/domains.html#domain-info
 // - https://docs.dnspod.com/api/5fe1b37d6e336701a2111f2b/
 func (s *DomainsService) Get(id, domain string) (Domain, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Set("domain_id", id)
-	payload.Set("domain", domain)
-
-	returnedDomain := domainWrapper{}
-
-	res, err := s.client.post(methodDomainInfo, payload, &returnedDomain)
-	if err != nil {
-		return Domain{}, res, err
-	}
-
-	return returnedDomain.Domain, res, nil
+	return s.GetWithContext(context.Background(), id, domain)
 }
 
 // Delete a domain.
@@ -142,10 +113,5 @@ func (s *DomainsService) Get(id, domain string) (Domain, *Response, error) {
 // - https://dnsapi.cn/Domain.Remove
 // - https://docs.dnspod.com/api/5fe1ac446e336701a2111dd1/
 func (s *DomainsService) Delete(id string, domain string) (*Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Set("domain_id", id)
-	payload.Set("domain", domain)
-	returnedDomain := domainWrapper{}
-
-	return s.client.post(methodDomainRemove, payload, &returnedDomain)
+	return s.DeleteWithContext(context.Background(), id, domain)
 }
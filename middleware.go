@@ -0,0 +1,182 @@
+package dnspod
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// requestFunc is the shape of Client.postContext: it submits method with
+// payload under ctx and decodes the result into v. Middlewares and the
+// transport itself must honor ctx cancellation/deadlines directly, rather
+// than racing the call in a goroutine.
+type requestFunc func(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error)
+
+// Middleware wraps a requestFunc to add cross-cutting behavior (retries,
+// rate limiting, tracing, ...) around every Client call.
+type Middleware func(next requestFunc) requestFunc
+
+// ClientOption configures optional behavior on a Client, such as retry and
+// rate-limiting middleware.
+type ClientOption func(*Client)
+
+// Use appends middlewares to the Client's chain, in the order they should
+// run: the first middleware passed sees the request first.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// doWithMiddleware runs method/payload through the Client's middleware
+// chain before handing off to postContext, which is responsible for
+// actually passing ctx to the HTTP transport.
+func (c *Client) doWithMiddleware(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error) {
+	next := c.postContext
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+
+	return next(ctx, method, payload, v)
+}
+
+// RetryPolicy configures RetryMiddleware.
+type RetryPolicy struct {
+	// MaxRetries is the number of attempts after the first, failed one.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry; subsequent retries
+	// double it, up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff delay.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is a ready-made RetryPolicy to pass to WithRetry or
+// RetryMiddleware; neither applies it on its own.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+}
+
+// retryableStatusCodes are DNSPod business status.code values that mean the
+// request can be retried as-is.
+var retryableStatusCodes = map[string]bool{
+	"-1": true, // server busy
+	"-8": true, // request too frequent
+	"85": true, // operation too frequent
+}
+
+// WithRetry returns a ClientOption that retries failed requests with
+// exponential backoff. It retries on HTTP 429/5xx responses and on the
+// DNSPod status.code values that mean "try again": -1, -8 and 85. Before a
+// retry it waits for the Retry-After header's delay (in whole seconds) when
+// the server sends one, and for the backoff delay otherwise.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.Use(RetryMiddleware(policy))
+	}
+}
+
+// RetryMiddleware builds a Middleware implementing the policy described by
+// WithRetry. It is exported separately so callers can combine it with their
+// own middleware chain instead of going through a ClientOption.
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	return func(next requestFunc) requestFunc {
+		return func(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error) {
+			var (
+				res *Response
+				err error
+			)
+
+			delay := policy.BaseDelay
+
+			for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+				res, err = next(ctx, method, payload, v)
+				if !shouldRetry(res, err, v) {
+					return res, err
+				}
+
+				if attempt == policy.MaxRetries {
+					break
+				}
+
+				select {
+				case <-ctx.Done():
+					return res, ctx.Err()
+				case <-time.After(retryAfter(res, delay)):
+				}
+
+				delay = time.Duration(math.Min(float64(delay*2), float64(policy.MaxDelay)))
+			}
+
+			return res, err
+		}
+	}
+}
+
+func shouldRetry(res *Response, err error, v interface{}) bool {
+	if err != nil {
+		return false
+	}
+
+	if res == nil {
+		return false
+	}
+
+	if res.StatusCode == 429 || res.StatusCode >= 500 {
+		return true
+	}
+
+	holder, ok := v.(statusHolder)
+	if !ok {
+		return false
+	}
+
+	return retryableStatusCodes[holder.dnspodStatus().Code]
+}
+
+func retryAfter(res *Response, fallback time.Duration) time.Duration {
+	if res == nil {
+		return fallback
+	}
+
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return fallback
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return fallback
+}
+
+// WithRateLimit returns a ClientOption that caps outgoing requests to r
+// requests per second, allowing bursts up to burst. DNSPod documents a cap
+// of 20 requests/second per account.
+func WithRateLimit(r float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.Use(RateLimitMiddleware(rate.NewLimiter(rate.Limit(r), burst)))
+	}
+}
+
+// DefaultRateLimit is the request rate DNSPod documents for its API.
+const DefaultRateLimit = 20
+
+// RateLimitMiddleware builds a Middleware that blocks until limiter allows
+// another request or ctx is done, whichever comes first.
+func RateLimitMiddleware(limiter *rate.Limiter) Middleware {
+	return func(next requestFunc) requestFunc {
+		return func(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, method, payload, v)
+		}
+	}
+}
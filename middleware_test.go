@@ -0,0 +1,59 @@
+package dnspod
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		name string
+		res  *Response
+		err  error
+		v    interface{}
+		want bool
+	}{
+		{"transport error", nil, errors.New("boom"), nil, false},
+		{"nil response, no error", nil, nil, nil, false},
+		{"http 429", &Response{StatusCode: 429}, nil, nil, true},
+		{"http 500", &Response{StatusCode: 500}, nil, nil, true},
+		{"http 200, no status holder", &Response{StatusCode: 200}, nil, nil, false},
+		{"retryable dnspod status", &Response{StatusCode: 200}, nil, batchJobWrapper{Status: Status{Code: "-1"}}, true},
+		{"non-retryable dnspod status", &Response{StatusCode: 200}, nil, batchJobWrapper{Status: Status{Code: "6"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shouldRetry(tt.res, tt.err, tt.v)
+			if got != tt.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	fallback := 500 * time.Millisecond
+
+	tests := []struct {
+		name string
+		res  *Response
+		want time.Duration
+	}{
+		{"nil response", nil, fallback},
+		{"no header", &Response{Header: http.Header{}}, fallback},
+		{"valid header", &Response{Header: http.Header{"Retry-After": {"2"}}}, 2 * time.Second},
+		{"non-numeric header", &Response{Header: http.Header{"Retry-After": {"soon"}}}, fallback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retryAfter(tt.res, fallback)
+			if got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
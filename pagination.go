@@ -0,0 +1,273 @@
+package dnspod
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// defaultPageSize is DNSPod's maximum Record.List/Domain.List page size,
+// used by ListAll when the caller doesn't request a specific Length.
+const defaultPageSize = 3000
+
+// listPage fetches a single page of records, returning the DomainInfo
+// alongside the records so callers that need RecordTotal (e.g.
+// RecordIterator) don't have to re-request it.
+func (s *RecordsService) listPage(ctx context.Context, p ListParams) ([]RawRecord, DomainInfo, error) {
+	payload := s.client.CommonParams.toPayLoad()
+	payload = p.ToURLValues(payload)
+	if p.DomainID != "" {
+		payload.Add("domain_id", p.DomainID)
+	}
+	if p.Domain != "" {
+		payload.Add("domain", p.Domain)
+	}
+	if p.RecordType != "" {
+		payload.Add("record_type", p.RecordType)
+	}
+
+	wrapped := recordsWrapper{}
+
+	_, err := s.client.postWithContext(ctx, methodRecordList, payload, &wrapped)
+	if err != nil {
+		return nil, DomainInfo{}, err
+	}
+
+	if wrapped.Status.Code != "1" && wrapped.Status.Code != "10" {
+		return nil, DomainInfo{}, fmt.Errorf("could not get domains: %s", wrapped.Status.Message)
+	}
+
+	return wrapped.Records, wrapped.Info, nil
+}
+
+// RecordIterator walks every record matching a ListParams query across as
+// many pages as DNSPod reports, transparently incrementing Offset. Obtain
+// one from RecordsService.ListAll.
+type RecordIterator struct {
+	ctx       context.Context
+	service   *RecordsService
+	params    ListParams
+	pageSize  int
+	offset    int
+	page      int
+	buf       []RawRecord
+	idx       int
+	current   RawRecord
+	total     int
+	haveTotal bool
+	done      bool
+	err       error
+}
+
+// ListAll returns an iterator over every record matching p. Pages are
+// fetched in p.Length-sized chunks (DNSPod's maximum of 3000 if p.Length is
+// unset).
+func (s *RecordsService) ListAll(ctx context.Context, p ListParams) *RecordIterator {
+	pageSize := defaultPageSize
+	if n, err := strconv.Atoi(p.Length); err == nil && n > 0 {
+		pageSize = n
+	}
+
+	return &RecordIterator{ctx: ctx, service: s, params: p, pageSize: pageSize}
+}
+
+// Next advances the iterator and reports whether a record is available via
+// Record. It returns false at end of stream and on error; use Err to tell
+// the two apart.
+func (it *RecordIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.buf) {
+		if !it.fetchPage() {
+			return false
+		}
+	}
+
+	it.current = it.buf[it.idx]
+	it.idx++
+
+	return true
+}
+
+// Record returns the record produced by the most recent call to Next.
+func (it *RecordIterator) Record() RawRecord {
+	return it.current
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *RecordIterator) Err() error {
+	return it.err
+}
+
+// Page returns the 1-indexed number of the most recently fetched page.
+func (it *RecordIterator) Page() int {
+	return it.page
+}
+
+// Collect materializes every remaining record into a slice. It stops and
+// returns an error once more than max records have been seen (max <= 0
+// means unlimited), guarding callers against runaway zones.
+func (it *RecordIterator) Collect(max int) ([]RawRecord, error) {
+	var all []RawRecord
+
+	for it.Next() {
+		if max > 0 && len(all) >= max {
+			return all, fmt.Errorf("dnspod: more than %d records, aborting", max)
+		}
+		all = append(all, it.Record())
+	}
+
+	return all, it.Err()
+}
+
+func (it *RecordIterator) fetchPage() bool {
+	if it.done {
+		return false
+	}
+
+	params := it.params
+	params.Offset = strconv.Itoa(it.offset)
+	params.Length = strconv.Itoa(it.pageSize)
+
+	records, info, err := it.service.listPage(it.ctx, params)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page++
+	it.buf = records
+	it.idx = 0
+	it.offset += len(records)
+
+	if total, convErr := info.RecordTotal.Int64(); convErr == nil && total > 0 {
+		it.total = int(total)
+		it.haveTotal = true
+	}
+
+	it.done = paginationDone(len(records), it.pageSize, it.offset, it.total, it.haveTotal)
+
+	return len(records) > 0
+}
+
+// paginationDone reports whether, having just appended a page of fetched
+// rows at a cumulative offset, there are no further pages left to request:
+// either the page came back short (DNSPod's signal that it was the last
+// one) or the cumulative offset has reached a known total.
+func paginationDone(fetched, pageSize, offset, total int, haveTotal bool) bool {
+	return fetched < pageSize || (haveTotal && offset >= total)
+}
+
+// DomainIterator walks every domain across as many pages as DNSPod reports,
+// transparently incrementing offset. Obtain one from
+// DomainsService.ListAll.
+type DomainIterator struct {
+	ctx       context.Context
+	service   *DomainsService
+	pageSize  int
+	offset    int
+	page      int
+	buf       []Domain
+	idx       int
+	current   Domain
+	total     int
+	haveTotal bool
+	done      bool
+	err       error
+}
+
+// ListAll returns an iterator over every domain on the account, fetching
+// pages of defaultPageSize domains at a time.
+func (s *DomainsService) ListAll(ctx context.Context) *DomainIterator {
+	return &DomainIterator{ctx: ctx, service: s, pageSize: defaultPageSize}
+}
+
+// Next advances the iterator and reports whether a domain is available via
+// Domain. It returns false at end of stream and on error; use Err to tell
+// the two apart.
+func (it *DomainIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.buf) {
+		if !it.fetchPage() {
+			return false
+		}
+	}
+
+	it.current = it.buf[it.idx]
+	it.idx++
+
+	return true
+}
+
+// Domain returns the domain produced by the most recent call to Next.
+func (it *DomainIterator) Domain() Domain {
+	return it.current
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *DomainIterator) Err() error {
+	return it.err
+}
+
+// Page returns the 1-indexed number of the most recently fetched page.
+func (it *DomainIterator) Page() int {
+	return it.page
+}
+
+// Collect materializes every remaining domain into a slice. It stops and
+// returns an error once more than max domains have been seen (max <= 0
+// means unlimited).
+func (it *DomainIterator) Collect(max int) ([]Domain, error) {
+	var all []Domain
+
+	for it.Next() {
+		if max > 0 && len(all) >= max {
+			return all, fmt.Errorf("dnspod: more than %d domains, aborting", max)
+		}
+		all = append(all, it.Domain())
+	}
+
+	return all, it.Err()
+}
+
+func (it *DomainIterator) fetchPage() bool {
+	if it.done {
+		return false
+	}
+
+	payload := it.service.client.CommonParams.toPayLoad()
+	payload.Set("offset", strconv.Itoa(it.offset))
+	payload.Set("length", strconv.Itoa(it.pageSize))
+
+	wrapped := domainListWrapper{}
+
+	_, err := it.service.client.postWithContext(it.ctx, methodDomainList, payload, &wrapped)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if wrapped.Status.Code != "1" && wrapped.Status.Code != "10" {
+		it.err = fmt.Errorf("could not get domains: %s", wrapped.Status.Message)
+		return false
+	}
+
+	it.page++
+	it.buf = wrapped.Domains
+	it.idx = 0
+	it.offset += len(wrapped.Domains)
+
+	if total, convErr := wrapped.Info.DomainTotal.Int64(); convErr == nil && total > 0 {
+		it.total = int(total)
+		it.haveTotal = true
+	}
+
+	it.done = paginationDone(len(wrapped.Domains), it.pageSize, it.offset, it.total, it.haveTotal)
+
+	return len(wrapped.Domains) > 0
+}
@@ -0,0 +1,31 @@
+package dnspod
+
+import "testing"
+
+func TestPaginationDone(t *testing.T) {
+	tests := []struct {
+		name      string
+		fetched   int
+		pageSize  int
+		offset    int
+		total     int
+		haveTotal bool
+		want      bool
+	}{
+		{"short page means last page", 10, 20, 10, 0, false, true},
+		{"full page with total not yet reached", 20, 20, 20, 100, true, false},
+		{"full page reaching known total", 20, 20, 100, 100, true, true},
+		{"full page with no total info", 20, 20, 20, 0, false, false},
+		{"empty page", 0, 20, 0, 0, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := paginationDone(tt.fetched, tt.pageSize, tt.offset, tt.total, tt.haveTotal)
+			if got != tt.want {
+				t.Errorf("paginationDone(%d, %d, %d, %d, %v) = %v, want %v",
+					tt.fetched, tt.pageSize, tt.offset, tt.total, tt.haveTotal, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package dnspod
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// RecordType identifies the type of a DNS resource record.
+type RecordType string
+
+// The record types supported by the DNSPod API.
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeMX    RecordType = "MX"
+	RecordTypeTXT   RecordType = "TXT"
+	RecordTypeSRV   RecordType = "SRV"
+	RecordTypeCAA   RecordType = "CAA"
+	RecordTypeNS    RecordType = "NS"
+	RecordTypeSPF   RecordType = "SPF"
+	RecordTypePTR   RecordType = "PTR"
+	RecordTypeHTTPS RecordType = "HTTPS"
+	RecordTypeSVCB  RecordType = "SVCB"
+)
+
+// caaTags lists the tags accepted in the "tag" field of a CAA record.
+var caaTags = map[string]bool{
+	"issue":     true,
+	"issuewild": true,
+	"iodef":     true,
+}
+
+// SRVTarget is the typed representation of the priority/weight/port/target
+// tuple carried by an SRV record. It marshals to the DNSPod "value" field as
+// "priority weight port target".
+type SRVTarget struct {
+	Priority uint16
+	Weight   uint16
+	Port     uint16
+	Target   string
+}
+
+func (t SRVTarget) String() string {
+	return fmt.Sprintf("%d %d %d %s", t.Priority, t.Weight, t.Port, t.Target)
+}
+
+func parseSRVTarget(value string) (SRVTarget, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 4 {
+		return SRVTarget{}, fmt.Errorf("dnspod: SRV record requires \"priority weight port target\", got %q", value)
+	}
+
+	priority, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return SRVTarget{}, fmt.Errorf("dnspod: invalid SRV priority %q: %w", fields[0], err)
+	}
+
+	weight, err := strconv.ParseUint(fields[1], 10, 16)
+	if err != nil {
+		return SRVTarget{}, fmt.Errorf("dnspod: invalid SRV weight %q: %w", fields[1], err)
+	}
+
+	port, err := strconv.ParseUint(fields[2], 10, 16)
+	if err != nil {
+		return SRVTarget{}, fmt.Errorf("dnspod: invalid SRV port %q: %w", fields[2], err)
+	}
+
+	return SRVTarget{
+		Priority: uint16(priority),
+		Weight:   uint16(weight),
+		Port:     uint16(port),
+		Target:   fields[3],
+	}, nil
+}
+
+// Validate checks that the record's fields are consistent with its Type.
+func (r Record) Validate() error {
+	if r.Type == "" {
+		return errors.New("dnspod: record type is required")
+	}
+
+	switch r.Type {
+	case RecordTypeA:
+		ip := net.ParseIP(r.Value)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("dnspod: invalid A record value %q", r.Value)
+		}
+	case RecordTypeAAAA:
+		ip := net.ParseIP(r.Value)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("dnspod: invalid AAAA record value %q", r.Value)
+		}
+	case RecordTypeMX:
+		if r.MX == nil {
+			return errors.New("dnspod: MX record requires a priority")
+		}
+		if r.Value == "" {
+			return errors.New("dnspod: MX record requires a target")
+		}
+	case RecordTypeSRV:
+		if r.SRV == nil {
+			return errors.New("dnspod: SRV record requires priority, weight, port and target")
+		}
+	case RecordTypeCAA:
+		if err := validateCAA(r.Value); err != nil {
+			return err
+		}
+	case RecordTypeTXT, RecordTypeSPF, RecordTypeCNAME, RecordTypeNS, RecordTypePTR, RecordTypeHTTPS, RecordTypeSVCB:
+		if r.Value == "" {
+			return fmt.Errorf("dnspod: %s record requires a value", r.Type)
+		}
+	}
+
+	return nil
+}
+
+// validateCAA checks that value is a well-formed CAA record: a flags byte
+// (0 or 128), a known tag, and a value. The value field must be present but
+// is not checked for quoting or content.
+func validateCAA(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) < 3 {
+		return fmt.Errorf("dnspod: CAA record requires \"flags tag value\", got %q", value)
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil || (flags != 0 && flags != 128) {
+		return fmt.Errorf("dnspod: invalid CAA flags %q, must be 0 or 128", fields[0])
+	}
+
+	tag := strings.ToLower(fields[1])
+	if !caaTags[tag] {
+		return fmt.Errorf("dnspod: invalid CAA tag %q", fields[1])
+	}
+
+	return nil
+}
+
+// PopulateFromString parses zone-file-style RDATA for rtype into the record,
+// resolving unqualified names against origin. It sets Type as a side effect
+// and validates the result.
+func (r *Record) PopulateFromString(rtype RecordType, contents, origin string) error {
+	r.Type = rtype
+	contents = strings.TrimSpace(contents)
+
+	switch rtype {
+	case RecordTypeMX:
+		fields := strings.Fields(contents)
+		if len(fields) != 2 {
+			return fmt.Errorf("dnspod: MX record requires \"priority target\", got %q", contents)
+		}
+
+		priority, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("dnspod: invalid MX priority %q: %w", fields[0], err)
+		}
+
+		mx := uint16(priority)
+		r.MX = &mx
+		r.Value = makeAbsolute(fields[1], origin)
+	case RecordTypeSRV:
+		target, err := parseSRVTarget(contents)
+		if err != nil {
+			return err
+		}
+
+		target.Target = makeAbsolute(target.Target, origin)
+		r.SRV = &target
+	case RecordTypeCNAME, RecordTypeNS, RecordTypePTR:
+		r.Value = makeAbsolute(contents, origin)
+	default:
+		r.Value = contents
+	}
+
+	return r.Validate()
+}
+
+// ToRecord converts r to the typed representation, parsing the
+// rtype-specific fields (MX priority, SRV target) out of their wire-level
+// string form.
+func (r RawRecord) ToRecord() (Record, error) {
+	record := Record{
+		ID:            r.ID,
+		Name:          r.Name,
+		Line:          r.Line,
+		LineID:        r.LineID,
+		Type:          RecordType(r.Type),
+		Value:         r.Value,
+		Weight:        r.Weight,
+		Enabled:       r.Enabled,
+		Status:        r.Status,
+		MonitorStatus: r.MonitorStatus,
+		Remark:        r.Remark,
+		UpdateOn:      r.UpdateOn,
+		UseAQB:        r.UseAQB,
+	}
+
+	if r.TTL != "" {
+		ttl, err := strconv.Atoi(r.TTL)
+		if err != nil {
+			return Record{}, fmt.Errorf("dnspod: invalid TTL %q: %w", r.TTL, err)
+		}
+		record.TTL = ttl
+	}
+
+	if r.MX != "" {
+		mx, err := strconv.ParseUint(r.MX, 10, 16)
+		if err != nil {
+			return Record{}, fmt.Errorf("dnspod: invalid MX priority %q: %w", r.MX, err)
+		}
+		mxVal := uint16(mx)
+		record.MX = &mxVal
+	}
+
+	if record.Type == RecordTypeSRV {
+		target, err := parseSRVTarget(r.Value)
+		if err != nil {
+			return Record{}, err
+		}
+		record.SRV = &target
+	}
+
+	return record, nil
+}
+
+// makeAbsolute appends origin to name if name is not already a fully
+// qualified (trailing-dot) domain name.
+func makeAbsolute(name, origin string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "." + strings.TrimSuffix(origin, ".") + "."
+}
@@ -0,0 +1,245 @@
+package dnspod
+
+import "testing"
+
+func TestRecordValidate(t *testing.T) {
+	mx := uint16(10)
+
+	tests := []struct {
+		name    string
+		record  Record
+		wantErr bool
+	}{
+		{"missing type", Record{Value: "203.0.113.1"}, true},
+		{"valid A", Record{Type: RecordTypeA, Value: "203.0.113.1"}, false},
+		{"A with IPv6 value", Record{Type: RecordTypeA, Value: "2001:db8::1"}, true},
+		{"A with garbage value", Record{Type: RecordTypeA, Value: "not-an-ip"}, true},
+		{"valid AAAA", Record{Type: RecordTypeAAAA, Value: "2001:db8::1"}, false},
+		{"AAAA with IPv4 value", Record{Type: RecordTypeAAAA, Value: "203.0.113.1"}, true},
+		{"MX missing priority", Record{Type: RecordTypeMX, Value: "mail.example.com."}, true},
+		{"MX missing value", Record{Type: RecordTypeMX, MX: &mx}, true},
+		{"valid MX", Record{Type: RecordTypeMX, MX: &mx, Value: "mail.example.com."}, false},
+		{"SRV missing target", Record{Type: RecordTypeSRV}, true},
+		{"valid SRV", Record{Type: RecordTypeSRV, SRV: &SRVTarget{Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com."}}, false},
+		{"valid CAA", Record{Type: RecordTypeCAA, Value: `0 issue "letsencrypt.org"`}, false},
+		{"invalid CAA", Record{Type: RecordTypeCAA, Value: "garbage"}, true},
+		{"TXT missing value", Record{Type: RecordTypeTXT}, true},
+		{"valid TXT", Record{Type: RecordTypeTXT, Value: "hello"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.record.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCAA(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"well-formed issue", `0 issue "letsencrypt.org"`, false},
+		{"well-formed issuewild", `0 issuewild "letsencrypt.org"`, false},
+		{"well-formed iodef", `0 iodef "mailto:admin@example.com"`, false},
+		{"critical flag", `128 issue "letsencrypt.org"`, false},
+		{"invalid flag", `1 issue "letsencrypt.org"`, true},
+		{"unknown tag", `0 bogus "letsencrypt.org"`, true},
+		{"too few fields", `0 issue`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCAA(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCAA(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPopulateFromString(t *testing.T) {
+	t.Run("MX", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeMX, "10 mail", "example.com"); err != nil {
+			t.Fatalf("PopulateFromString() error = %v", err)
+		}
+
+		if r.MX == nil || *r.MX != 10 {
+			t.Errorf("MX = %v, want 10", r.MX)
+		}
+		if want := "mail.example.com."; r.Value != want {
+			t.Errorf("Value = %q, want %q", r.Value, want)
+		}
+	})
+
+	t.Run("MX with already-absolute target", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeMX, "10 mail.other.com.", "example.com"); err != nil {
+			t.Fatalf("PopulateFromString() error = %v", err)
+		}
+
+		if want := "mail.other.com."; r.Value != want {
+			t.Errorf("Value = %q, want %q", r.Value, want)
+		}
+	})
+
+	t.Run("MX malformed", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeMX, "10 mail extra", "example.com"); err == nil {
+			t.Error("PopulateFromString() error = nil, want error")
+		}
+	})
+
+	t.Run("SRV", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeSRV, "10 20 5060 sip", "example.com"); err != nil {
+			t.Fatalf("PopulateFromString() error = %v", err)
+		}
+
+		want := SRVTarget{Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com."}
+		if r.SRV == nil || *r.SRV != want {
+			t.Errorf("SRV = %v, want %v", r.SRV, want)
+		}
+	})
+
+	t.Run("CNAME relative", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeCNAME, "target", "example.com"); err != nil {
+			t.Fatalf("PopulateFromString() error = %v", err)
+		}
+
+		if want := "target.example.com."; r.Value != want {
+			t.Errorf("Value = %q, want %q", r.Value, want)
+		}
+	})
+
+	t.Run("TXT left untouched", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeTXT, "  v=spf1 -all  ", "example.com"); err != nil {
+			t.Fatalf("PopulateFromString() error = %v", err)
+		}
+
+		if want := "v=spf1 -all"; r.Value != want {
+			t.Errorf("Value = %q, want %q", r.Value, want)
+		}
+	})
+
+	t.Run("invalid result fails Validate", func(t *testing.T) {
+		var r Record
+		if err := r.PopulateFromString(RecordTypeCAA, "garbage", "example.com"); err == nil {
+			t.Error("PopulateFromString() error = nil, want error")
+		}
+	})
+}
+
+func TestParseSRVTarget(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := parseSRVTarget("10 20 5060 sip.example.com.")
+		if err != nil {
+			t.Fatalf("parseSRVTarget() error = %v", err)
+		}
+
+		want := SRVTarget{Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com."}
+		if got != want {
+			t.Errorf("parseSRVTarget() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("wrong field count", func(t *testing.T) {
+		if _, err := parseSRVTarget("10 20 5060"); err == nil {
+			t.Error("parseSRVTarget() error = nil, want error")
+		}
+	})
+
+	t.Run("non-numeric priority", func(t *testing.T) {
+		if _, err := parseSRVTarget("x 20 5060 sip.example.com."); err == nil {
+			t.Error("parseSRVTarget() error = nil, want error")
+		}
+	})
+
+	t.Run("port out of range", func(t *testing.T) {
+		if _, err := parseSRVTarget("10 20 70000 sip.example.com."); err == nil {
+			t.Error("parseSRVTarget() error = nil, want error")
+		}
+	})
+}
+
+func TestMakeAbsolute(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		origin string
+		want   string
+	}{
+		{"relative name", "www", "example.com", "www.example.com."},
+		{"already absolute", "www.example.com.", "example.com", "www.example.com."},
+		{"origin with trailing dot", "www", "example.com.", "www.example.com."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := makeAbsolute(tt.target, tt.origin)
+			if got != tt.want {
+				t.Errorf("makeAbsolute(%q, %q) = %q, want %q", tt.target, tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRawRecordToRecord(t *testing.T) {
+	t.Run("MX", func(t *testing.T) {
+		raw := RawRecord{ID: "1", Type: "MX", Value: "mail.example.com.", MX: "10", TTL: "600"}
+
+		record, err := raw.ToRecord()
+		if err != nil {
+			t.Fatalf("ToRecord() error = %v", err)
+		}
+
+		if record.MX == nil || *record.MX != 10 {
+			t.Errorf("MX = %v, want 10", record.MX)
+		}
+		if record.TTL != 600 {
+			t.Errorf("TTL = %d, want 600", record.TTL)
+		}
+	})
+
+	t.Run("SRV", func(t *testing.T) {
+		raw := RawRecord{ID: "2", Type: "SRV", Value: "10 20 5060 sip.example.com."}
+
+		record, err := raw.ToRecord()
+		if err != nil {
+			t.Fatalf("ToRecord() error = %v", err)
+		}
+
+		want := SRVTarget{Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com."}
+		if record.SRV == nil || *record.SRV != want {
+			t.Errorf("SRV = %v, want %v", record.SRV, want)
+		}
+	})
+
+	t.Run("invalid MX priority", func(t *testing.T) {
+		raw := RawRecord{ID: "3", Type: "MX", Value: "mail.example.com.", MX: "not-a-number"}
+
+		if _, err := raw.ToRecord(); err == nil {
+			t.Error("ToRecord() error = nil, want error")
+		}
+	})
+
+	t.Run("plain A record", func(t *testing.T) {
+		raw := RawRecord{ID: "4", Type: "A", Value: "203.0.113.1"}
+
+		record, err := raw.ToRecord()
+		if err != nil {
+			t.Fatalf("ToRecord() error = %v", err)
+		}
+
+		if record.Value != "203.0.113.1" {
+			t.Errorf("Value = %q, want %q", record.Value, "203.0.113.1")
+		}
+	})
+}
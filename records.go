@@ -1,8 +1,8 @@
 package dnspod
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -15,8 +15,12 @@ const (
 	methodRecordModify = "Record.Modify"
 )
 
-// Record is the DNS record representation.
-type Record struct {
+// RawRecord is the wire-level DNS record representation, with every field
+// as the string DNSPod's API sends and expects. It is kept around for
+// callers that built Record literals before Record gained typed,
+// rtype-specific fields; new code should prefer Record. Call ToRecord to
+// convert a RawRecord read back from the API into the typed form.
+type RawRecord struct {
 	ID            string `json:"id,omitempty"`
 	Name          string `json:"name,omitempty"`
 	Line          string `json:"line,omitempty"`
@@ -34,6 +38,73 @@ type Record struct {
 	Weight        *int   `json:"weight,omitempty"`
 }
 
+// Record is the DNS record representation, with TTL and rtype-specific
+// fields (MX priority, SRV target, ...) typed instead of hand-encoded into
+// Value. It has no JSON tags of its own; it is converted to RawRecord's
+// wire shape by toRaw and read back from it by RawRecord.ToRecord.
+type Record struct {
+	ID            string
+	Name          string
+	Line          string
+	LineID        string
+	Type          RecordType
+	TTL           int
+	Value         string
+	MX            *uint16
+	Weight        *int
+	SRV           *SRVTarget
+	Enabled       string
+	Status        string
+	MonitorStatus string
+	Remark        string
+	UpdateOn      string
+	UseAQB        string
+}
+
+// toRaw converts r to the wire-level representation sent to the DNSPod API.
+func (r Record) toRaw() RawRecord {
+	raw := RawRecord{
+		ID:            r.ID,
+		Name:          r.Name,
+		Line:          r.Line,
+		LineID:        r.LineID,
+		Type:          string(r.Type),
+		Value:         r.Value,
+		Weight:        r.Weight,
+		Enabled:       r.Enabled,
+		Status:        r.Status,
+		MonitorStatus: r.MonitorStatus,
+		Remark:        r.Remark,
+		UpdateOn:      r.UpdateOn,
+		UseAQB:        r.UseAQB,
+	}
+
+	if r.TTL != 0 {
+		raw.TTL = strconv.Itoa(r.TTL)
+	}
+
+	if r.MX != nil {
+		raw.MX = strconv.FormatUint(uint64(*r.MX), 10)
+	}
+
+	if r.SRV != nil {
+		raw.Value = r.SRV.String()
+	}
+
+	return raw
+}
+
+// recordAttributes is implemented by both Record and RawRecord so
+// RecordsService.Create and RecordsService.Update can accept either
+// representation.
+type recordAttributes interface {
+	toRaw() RawRecord
+}
+
+func (r RawRecord) toRaw() RawRecord {
+	return r
+}
+
 // RecordModify is the DNS record modify representation.
 type RecordModify struct {
 	ID     json.Number `json:"id,omitempty"`
@@ -43,22 +114,28 @@ type RecordModify struct {
 }
 
 type recordsWrapper struct {
-	Status  Status     `json:"status"`
-	Info    DomainInfo `json:"info"`
-	Records []Record   `json:"records"`
+	Status  Status      `json:"status"`
+	Info    DomainInfo  `json:"info"`
+	Records []RawRecord `json:"records"`
 }
 
+func (w recordsWrapper) dnspodStatus() Status { return w.Status }
+
 type recordWrapper struct {
 	Status Status     `json:"status"`
 	Info   DomainInfo `json:"info"`
-	Record Record     `json:"record"`
+	Record RawRecord  `json:"record"`
 }
 
+func (w recordWrapper) dnspodStatus() Status { return w.Status }
+
 type recordModifyWrapper struct {
 	Status Status       `json:"status"`
 	Record RecordModify `json:"record"`
 }
 
+func (w recordModifyWrapper) dnspodStatus() Status { return w.Status }
+
 // RecordsService handles communication with the DNS records related methods of the dnspod API.
 //
 // DNSPod API docs:
@@ -123,90 +200,58 @@ func (p ListParams) ToURLValues(values url.Values) url.Values {
 }
 
 func (s *RecordsService) List(p ListParams) ([]Record, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload = p.ToURLValues(payload)
-	if p.DomainID != "" {
-		payload.Add("domain_id", p.DomainID)
-	}
-	if p.Domain != "" {
-		payload.Add("domain", p.Domain)
-	}
-	if p.RecordType != "" {
-		payload.Add("record_type", p.RecordType)
-	}
-
-	wrappedRecords := recordsWrapper{}
-
-	res, err := s.client.post(methodRecordList, payload, &wrappedRecords)
-	if err != nil {
-		return nil, res, err
-	}
-
-	if wrappedRecords.Status.Code != "1" && wrappedRecords.Status.Code != "10" {
-		return nil, nil, fmt.Errorf("could not get domains: %s", wrappedRecords.Status.Message)
-	}
-
-	return wrappedRecords.Records, res, nil
+	return s.ListWithContext(context.Background(), p)
 }
 
-// Create Creates a domain record.
-//
-// DNSPod API docs:
-// - https://www.dnspod.cn/docs/records.html#record-create
-// - https://docs.dnspod.com/api/5fe19a3f6e336701a2111bb0/
-func (s *RecordsService) Create(domain, domainId string, recordAttributes Record) (Record, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Add("domain", domain)
-	payload.Add("domain_id", domainId)
+// applyRecordAttributes sets the record fields shared by Record.Create and
+// Record.Modify onto payload, accepting either a Record or a RawRecord.
+func applyRecordAttributes(payload url.Values, attrs recordAttributes) {
+	raw := attrs.toRaw()
 
-	if recordAttributes.Name != "" {
-		payload.Add("sub_domain", recordAttributes.Name)
+	if raw.Name != "" {
+		payload.Add("sub_domain", raw.Name)
 	}
 
-	if recordAttributes.Type != "" {
-		payload.Add("record_type", recordAttributes.Type)
+	if raw.Type != "" {
+		payload.Add("record_type", raw.Type)
 	}
 
-	if recordAttributes.Line != "" {
-		payload.Add("record_line", recordAttributes.Line)
+	if raw.Line != "" {
+		payload.Add("record_line", raw.Line)
 	}
 
-	if recordAttributes.LineID != "" {
-		payload.Add("record_line_id", recordAttributes.LineID)
+	if raw.LineID != "" {
+		payload.Add("record_line_id", raw.LineID)
 	}
 
-	if recordAttributes.Value != "" {
-		payload.Add("value", recordAttributes.Value)
+	if raw.Value != "" {
+		payload.Add("value", raw.Value)
 	}
 
-	if recordAttributes.MX != "" {
-		payload.Add("mx", recordAttributes.MX)
+	if raw.MX != "" {
+		payload.Add("mx", raw.MX)
 	}
 
-	if recordAttributes.TTL != "" {
-		payload.Add("ttl", recordAttributes.TTL)
+	if raw.TTL != "" {
+		payload.Add("ttl", raw.TTL)
 	}
 
-	if recordAttributes.Status != "" {
-		payload.Add("status", recordAttributes.Status)
-	}
-
-	if recordAttributes.Weight != nil {
-		payload.Add("weight", strconv.Itoa(*recordAttributes.Weight))
-	}
-
-	returnedRecord := recordWrapper{}
-
-	res, err := s.client.post(methodRecordCreate, payload, &returnedRecord)
-	if err != nil {
-		return Record{}, res, err
+	if raw.Status != "" {
+		payload.Add("status", raw.Status)
 	}
 
-	if returnedRecord.Status.Code != "1" {
-		return returnedRecord.Record, nil, fmt.Errorf("could not create domains: %s", returnedRecord.Status.Message)
+	if raw.Weight != nil {
+		payload.Add("weight", strconv.Itoa(*raw.Weight))
 	}
+}
 
-	return returnedRecord.Record, res, nil
+// Create Creates a domain record.
+//
+// DNSPod API docs:
+// - https://www.dnspod.cn/docs/records.html#record-create
+// - https://docs.dnspod.com/api/5fe19a3f6e336701a2111bb0/
+func (s *RecordsService) Create(domain, domainId string, attrs recordAttributes) (RawRecord, *Response, error) {
+	return s.CreateWithContext(context.Background(), domain, domainId, attrs)
 }
 
 // Get Fetches the domain record.
@@ -215,23 +260,7 @@ func (s *RecordsService) Create(domain, domainId string, recordAttributes Record
 // - https://www.dnspod.cn/docs/records.html#record-info
 // - https://docs.dnspod.com/api/5fe1a2a06e336701a2111bcd/
 func (s *RecordsService) Get(domain, domainId string, recordID int) (Record, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Add("domain", domain)
-	payload.Add("domain_id", domainId)
-	payload.Add("record_id", strconv.Itoa(recordID))
-
-	returnedRecord := recordWrapper{}
-
-	res, err := s.client.post(methodRecordInfo, payload, &returnedRecord)
-	if err != nil {
-		return Record{}, res, err
-	}
-
-	if returnedRecord.Status.Code != "1" {
-		return returnedRecord.Record, nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
-	}
-
-	return returnedRecord.Record, res, nil
+	return s.GetWithContext(context.Background(), domain, domainId, recordID)
 }
 
 // Update Updates a domain record.
@@ -239,60 +268,8 @@ func (s *RecordsService) Get(domain, domainId string, recordID int) (Record, *Re
 // DNSPod API docs:
 // - https://www.dnspod.cn/docs/records.html#record-modify
 // - https://docs.dnspod.com/api/5fe1a5a16e336701a2111c76/
-func (s *RecordsService) Update(domainId, domain, recordID string, recordAttributes Record) (RecordModify, *Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Add("domain_id", domainId)
-	payload.Add("domain", domain)
-	payload.Add("record_id", recordID)
-
-	if recordAttributes.Name != "" {
-		payload.Add("sub_domain", recordAttributes.Name)
-	}
-
-	if recordAttributes.Type != "" {
-		payload.Add("record_type", recordAttributes.Type)
-	}
-
-	if recordAttributes.Line != "" {
-		payload.Add("record_line", recordAttributes.Line)
-	}
-
-	if recordAttributes.LineID != "" {
-		payload.Add("record_line_id", recordAttributes.LineID)
-	}
-
-	if recordAttributes.Value != "" {
-		payload.Add("value", recordAttributes.Value)
-	}
-
-	if recordAttributes.MX != "" {
-		payload.Add("mx", recordAttributes.MX)
-	}
-
-	if recordAttributes.TTL != "" {
-		payload.Add("ttl", recordAttributes.TTL)
-	}
-
-	if recordAttributes.Status != "" {
-		payload.Add("status", recordAttributes.Status)
-	}
-
-	if recordAttributes.Weight != nil {
-		payload.Add("weight", strconv.Itoa(*recordAttributes.Weight))
-	}
-
-	returnedRecord := recordModifyWrapper{}
-
-	res, err := s.client.post(methodRecordModify, payload, &returnedRecord)
-	if err != nil {
-		return RecordModify{}, res, err
-	}
-
-	if returnedRecord.Status.Code != "1" {
-		return returnedRecord.Record, nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
-	}
-
-	return returnedRecord.Record, res, nil
+func (s *RecordsService) Update(domainId, domain, recordID string, attrs recordAttributes) (RecordModify, *Response, error) {
+	return s.UpdateWithContext(context.Background(), domainId, domain, recordID, attrs)
 }
 
 // Delete Deletes a domain record.
@@ -301,21 +278,5 @@ func (s *RecordsService) Update(domainId, domain, recordID string, recordAttribu
 // - https://www.dnspod.cn/docs/records.html#record-remove
 // - https://docs.dnspod.com/api/5fe1a4576e336701a2111c24/
 func (s *RecordsService) Delete(domainId int, domain, recordID string) (*Response, error) {
-	payload := s.client.CommonParams.toPayLoad()
-	payload.Add("domain_id", strconv.Itoa(domainId))
-	payload.Add("domain", domain)
-	payload.Add("record_id", recordID)
-
-	returnedRecord := recordWrapper{}
-
-	res, err := s.client.post(methodRecordRemove, payload, &returnedRecord)
-	if err != nil {
-		return res, err
-	}
-
-	if returnedRecord.Status.Code != "1" {
-		return nil, fmt.Errorf("could not get domains: %s", returnedRecord.Status.Message)
-	}
-
-	return res, nil
+	return s.DeleteWithContext(context.Background(), domainId, domain, recordID)
 }
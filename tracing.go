@@ -0,0 +1,113 @@
+package dnspod
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// statusHolder is implemented by every response wrapper type so
+// observabilityMiddleware can read the DNSPod business status out of the
+// decoded body without knowing its concrete type.
+type statusHolder interface {
+	dnspodStatus() Status
+}
+
+// RequestLogger receives a callback before and after every API call, for
+// callers that want structured logging without wiring up OpenTelemetry.
+// A nil RequestLogger (the default) disables logging entirely.
+type RequestLogger interface {
+	LogRequest(method string, params url.Values)
+	LogResponse(method string, status Status, latency time.Duration)
+}
+
+// secretParams lists the payload fields scrubbed before being handed to a
+// RequestLogger.
+var secretParams = map[string]bool{
+	"login_token": true,
+	"user_token":  true,
+}
+
+// scrubbed returns a copy of params with secret fields replaced.
+func scrubbed(params url.Values) url.Values {
+	clean := make(url.Values, len(params))
+	for key, values := range params {
+		if secretParams[key] {
+			clean[key] = []string{"REDACTED"}
+			continue
+		}
+		clean[key] = values
+	}
+
+	return clean
+}
+
+// observabilityMiddleware starts an OpenTelemetry span per call when tracer
+// is non-nil, and calls logger's hooks when logger is non-nil. Both are
+// no-ops when their argument is nil, so a Client with neither configured
+// sees no behavior change.
+func observabilityMiddleware(tracer trace.Tracer, logger RequestLogger) Middleware {
+	return func(next requestFunc) requestFunc {
+		return func(ctx context.Context, method string, payload url.Values, v interface{}) (*Response, error) {
+			if logger != nil {
+				logger.LogRequest(method, scrubbed(payload))
+			}
+
+			start := time.Now()
+
+			var span trace.Span
+			if tracer != nil {
+				ctx, span = tracer.Start(ctx, "dnspod."+method)
+				span.SetAttributes(
+					attribute.String("dnspod.method", method),
+					attribute.String("dnspod.domain", payload.Get("domain")),
+					attribute.String("dnspod.record_id", payload.Get("record_id")),
+				)
+				defer span.End()
+			}
+
+			res, err := next(ctx, method, payload, v)
+
+			var status Status
+			if holder, ok := v.(statusHolder); ok {
+				status = holder.dnspodStatus()
+			}
+
+			if span != nil {
+				if res != nil {
+					span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+				}
+				span.SetAttributes(attribute.String("dnspod.status_code", status.Code))
+				if status.Code != "" && status.Code != "1" {
+					span.SetStatus(codes.Error, status.Message)
+				}
+			}
+
+			if logger != nil {
+				logger.LogResponse(method, status, time.Since(start))
+			}
+
+			return res, err
+		}
+	}
+}
+
+// WithTracer returns a ClientOption that starts an OpenTelemetry span
+// around every API call, named "dnspod.<Method>".
+func WithTracer(tracer trace.Tracer) ClientOption {
+	return func(c *Client) {
+		c.Use(observabilityMiddleware(tracer, nil))
+	}
+}
+
+// WithRequestLogger returns a ClientOption that reports every API call to
+// logger, with login_token/user_token scrubbed from the logged params.
+func WithRequestLogger(logger RequestLogger) ClientOption {
+	return func(c *Client) {
+		c.Use(observabilityMiddleware(nil, logger))
+	}
+}
@@ -0,0 +1,31 @@
+package dnspod
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestScrubbed(t *testing.T) {
+	params := url.Values{
+		"login_token": {"12345,supersecret"},
+		"user_token":  {"abcdef"},
+		"domain":      {"example.com"},
+	}
+
+	clean := scrubbed(params)
+
+	if got := clean.Get("login_token"); got != "REDACTED" {
+		t.Errorf("login_token = %q, want REDACTED", got)
+	}
+	if got := clean.Get("user_token"); got != "REDACTED" {
+		t.Errorf("user_token = %q, want REDACTED", got)
+	}
+	if got := clean.Get("domain"); got != "example.com" {
+		t.Errorf("domain = %q, want unchanged", got)
+	}
+
+	// The original params must be untouched.
+	if got := params.Get("login_token"); got != "12345,supersecret" {
+		t.Errorf("scrubbed mutated the original params: login_token = %q", got)
+	}
+}